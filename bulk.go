@@ -0,0 +1,169 @@
+package mgo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WriteOp is a single operation within a BulkWrite call. Build one with
+// InsertOneOp, UpdateOneOp, UpdateManyOp, DeleteOneOp, DeleteManyOp or
+// ReplaceOneOp.
+type WriteOp[T any] struct {
+	model mongo.WriteModel
+}
+
+// InsertOneOp inserts model.
+func InsertOneOp[T any](model T) WriteOp[T] {
+	return WriteOp[T]{model: mongo.NewInsertOneModel().SetDocument(newRecord(model))}
+}
+
+// UpdateOneOp updates the first document matching filter, excluding
+// soft-deleted documents.
+func UpdateOneOp[T any](filter, update bson.M) WriteOp[T] {
+	return WriteOp[T]{model: mongo.NewUpdateOneModel().SetFilter(scopeDeletedFilter(filter)).SetUpdate(update)}
+}
+
+// UpdateManyOp updates every document matching filter, excluding
+// soft-deleted documents.
+func UpdateManyOp[T any](filter, update bson.M) WriteOp[T] {
+	return WriteOp[T]{model: mongo.NewUpdateManyModel().SetFilter(scopeDeletedFilter(filter)).SetUpdate(update)}
+}
+
+// DeleteOneOp deletes the first document matching filter. Like
+// Collecter.DeleteOne, it is not scoped to exclude soft-deleted documents,
+// so it can also purge them.
+func DeleteOneOp[T any](filter bson.M) WriteOp[T] {
+	return WriteOp[T]{model: mongo.NewDeleteOneModel().SetFilter(filter)}
+}
+
+// DeleteManyOp deletes every document matching filter. Like
+// Collecter.DeleteMany, it is not scoped to exclude soft-deleted documents,
+// so it can also purge them.
+func DeleteManyOp[T any](filter bson.M) WriteOp[T] {
+	return WriteOp[T]{model: mongo.NewDeleteManyModel().SetFilter(filter)}
+}
+
+// ReplaceOneOp replaces the first document matching filter with model,
+// excluding soft-deleted documents and preserving the matched document's
+// existing _id.
+func ReplaceOneOp[T any](filter bson.M, model T) WriteOp[T] {
+	filter = scopeDeletedFilter(filter)
+
+	doc, err := stripID(model)
+	if err != nil {
+		// Deferred to BulkWrite, which marshals through the driver anyway
+		// and will surface the same error there.
+		return WriteOp[T]{model: mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(model)}
+	}
+
+	return WriteOp[T]{model: mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc)}
+}
+
+// BulkResult reports the outcome of a BulkWrite call.
+type BulkResult struct {
+	InsertedCount int64
+	MatchedCount  int64
+	ModifiedCount int64
+	DeletedCount  int64
+	UpsertedCount int64
+	UpsertedIDs   map[int64]any
+}
+
+// BulkWrite executes ops in a single round trip.
+func (c *collection[T]) BulkWrite(ctx context.Context, ops []WriteOp[T]) (BulkResult, error) {
+	models := make([]mongo.WriteModel, len(ops))
+	for i, op := range ops {
+		models[i] = op.model
+	}
+
+	res, err := c.Collection.BulkWrite(c.ctx(ctx), models)
+	if err != nil {
+		return BulkResult{}, err
+	}
+
+	return BulkResult{
+		InsertedCount: res.InsertedCount,
+		MatchedCount:  res.MatchedCount,
+		ModifiedCount: res.ModifiedCount,
+		DeletedCount:  res.DeletedCount,
+		UpsertedCount: res.UpsertedCount,
+		UpsertedIDs:   res.UpsertedIDs,
+	}, nil
+}
+
+// UpsertResult reports the outcome of an Upsert call.
+type UpsertResult struct {
+	MatchedCount  int64
+	ModifiedCount int64
+	UpsertedID    any
+}
+
+// Upsert updates one document matching options, inserting it if no document
+// matches.
+func (c *collection[T]) Upsert(ctx context.Context, opts ...Option) (UpsertResult, error) {
+	opt := bindOptions(opts...)
+	opt.scopeDeleted()
+	opt.setUpdate()
+	opt.setUpsertInsert()
+
+	res, err := c.Collection.UpdateOne(c.ctx(ctx), opt.Filter, opt.Update, options.Update().SetUpsert(true))
+	if err != nil {
+		return UpsertResult{}, err
+	}
+
+	return UpsertResult{
+		MatchedCount:  res.MatchedCount,
+		ModifiedCount: res.ModifiedCount,
+		UpsertedID:    res.UpsertedID,
+	}, nil
+}
+
+// FindOneAndUpdate updates one document and returns it as it looked after
+// the update.
+func (c *collection[T]) FindOneAndUpdate(ctx context.Context, opts ...Option) (*T, error) {
+	opt := bindOptions(opts...)
+	opt.scopeDeleted()
+	opt.setUpdate()
+
+	var model T
+	err := c.Collection.FindOneAndUpdate(
+		c.ctx(ctx), opt.Filter, opt.Update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&model)
+
+	return &model, err
+}
+
+// FindOneAndDelete deletes one document and returns it as it looked before
+// the delete. Like DeleteOne/DeleteMany, it is not scoped to exclude
+// soft-deleted documents, so it can also purge them.
+func (c *collection[T]) FindOneAndDelete(ctx context.Context, opts ...Option) (*T, error) {
+	opt := bindOptions(opts...)
+
+	var model T
+	err := c.Collection.FindOneAndDelete(c.ctx(ctx), opt.Filter).Decode(&model)
+	return &model, err
+}
+
+// FindOneAndReplace replaces one document with model, preserving its
+// existing _id, and returns it as it looked after the replace.
+func (c *collection[T]) FindOneAndReplace(ctx context.Context, model T, opts ...Option) (*T, error) {
+	opt := bindOptions(opts...)
+	opt.scopeDeleted()
+
+	doc, err := stripID(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var out T
+	err = c.Collection.FindOneAndReplace(
+		c.ctx(ctx), opt.Filter, doc,
+		options.FindOneAndReplace().SetReturnDocument(options.After),
+	).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,37 @@
+package mgo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDeleteOpsAreNotScopedForSoftDelete(t *testing.T) {
+	filter := bson.M{"status": "active"}
+
+	oneModel := DeleteOneOp[testDoc](filter).model.(*mongo.DeleteOneModel)
+	if _, ok := oneModel.Filter.(bson.M)["deleted_at"]; ok {
+		t.Errorf("DeleteOneOp scoped its filter, want it unscoped like DeleteOne: %#v", oneModel.Filter)
+	}
+
+	manyModel := DeleteManyOp[testDoc](filter).model.(*mongo.DeleteManyModel)
+	if _, ok := manyModel.Filter.(bson.M)["deleted_at"]; ok {
+		t.Errorf("DeleteManyOp scoped its filter, want it unscoped like DeleteMany: %#v", manyModel.Filter)
+	}
+}
+
+func TestUpdateOpsAreScopedForSoftDelete(t *testing.T) {
+	filter := bson.M{"status": "active"}
+	update := bson.M{"$set": bson.M{"status": "inactive"}}
+
+	oneModel := UpdateOneOp[testDoc](filter, update).model.(*mongo.UpdateOneModel)
+	if v, ok := oneModel.Filter.(bson.M)["deleted_at"]; !ok || v != nil {
+		t.Errorf("UpdateOneOp.Filter = %#v, want a deleted_at:nil scope", oneModel.Filter)
+	}
+
+	manyModel := UpdateManyOp[testDoc](filter, update).model.(*mongo.UpdateManyModel)
+	if v, ok := manyModel.Filter.(bson.M)["deleted_at"]; !ok || v != nil {
+		t.Errorf("UpdateManyOp.Filter = %#v, want a deleted_at:nil scope", manyModel.Filter)
+	}
+}
@@ -40,6 +40,26 @@ func newRecord(v any) any {
 	}
 }
 
+// stripID marshals v and drops its _id field, so it can be passed as a
+// replacement document without MongoDB rejecting an _id change; the
+// existing _id is preserved by the driver when the field is absent.
+func stripID(v any) (bson.M, error) {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	delete(doc, "_id")
+	doc["updated_at"] = time.Now()
+
+	return doc, nil
+}
+
 func (e Entity) entity() Entity {
 	return e
 }
@@ -80,11 +100,54 @@ type Collecter[T any] interface {
 	// SoftDeleteMany soft delete many documents by setting deleted_at.
 	SoftDeleteMany(ctx context.Context, options ...Option) error
 
+	// Restore unsets deleted_at on documents matching the given filter,
+	// undoing a prior SoftDeleteOne/SoftDeleteMany.
+	Restore(ctx context.Context, options ...Option) error
+
 	// Count count documents.
 	Count(ctx context.Context, options ...Option) (int64, error)
 
 	// Aggregate aggregate documents.
 	Aggregate(ctx context.Context, options ...Option) ([]T, error)
+
+	// Watch subscribes to a change stream scoped to this collection,
+	// decoding each event's fullDocument into T.
+	Watch(ctx context.Context, pipeline []bson.M, options ...Option) (<-chan ChangeEvent[T], error)
+
+	// WithSession returns a Collecter bound to sc, so that every call made
+	// through it participates in sc's transaction.
+	WithSession(sc mongo.SessionContext) Collecter[T]
+
+	// Query starts a chainable query builder against this collection.
+	Query() *Query[T]
+
+	// EnsureIndexes creates indexes, leaving any that already exist untouched.
+	EnsureIndexes(ctx context.Context, indexes []mongo.IndexModel) error
+
+	// BulkWrite executes a batch of insert/update/delete/replace ops in a
+	// single round trip.
+	BulkWrite(ctx context.Context, ops []WriteOp[T]) (BulkResult, error)
+
+	// Upsert updates one document matching options, inserting it if no
+	// document matches.
+	Upsert(ctx context.Context, options ...Option) (UpsertResult, error)
+
+	// FindOneAndUpdate updates one document and returns it as it looked
+	// after the update.
+	FindOneAndUpdate(ctx context.Context, options ...Option) (*T, error)
+
+	// FindOneAndDelete deletes one document and returns it as it looked
+	// before the delete.
+	FindOneAndDelete(ctx context.Context, options ...Option) (*T, error)
+
+	// FindOneAndReplace replaces one document with model and returns it as
+	// it looked after the replace.
+	FindOneAndReplace(ctx context.Context, model T, options ...Option) (*T, error)
+
+	// FindPage finds up to limit documents after the document identified by
+	// token, returning the next page's token, or "" once there are no more
+	// documents.
+	FindPage(ctx context.Context, token string, limit int64, options ...Option) ([]T, string, error)
 }
 
 // Option is a function to set option.
@@ -98,8 +161,10 @@ type option struct {
 	// Update is a update to update.
 	Update bson.M
 
-	// Sort is a sort to sort.
-	Sort bson.M
+	// Sort is a sort to sort. It is ordered (bson.D, not bson.M) so that
+	// multi-key sorts have a deterministic key order, which FindPage relies
+	// on to pick a single, stable anchor field.
+	Sort bson.D
 
 	// Projection is a projection to projection.
 	Projection bson.M
@@ -112,6 +177,83 @@ type option struct {
 
 	// Limit is a limit to limit.
 	Limit *int64
+
+	// MaxAwaitTime is the max time the server waits for new data on a Watch call.
+	MaxAwaitTime *time.Duration
+
+	// ResumeAfter is the resume token to resume a Watch call after.
+	ResumeAfter bson.Raw
+
+	// StartAfter is the resume token to start a Watch call after.
+	StartAfter bson.Raw
+
+	// FullDocument is the FullDocument mode for a Watch call.
+	FullDocument options.FullDocument
+
+	// deletedScope controls how soft-deleted documents are scoped into or
+	// out of the filter. Its zero value excludes soft-deleted documents.
+	deletedScope deletedScope
+}
+
+// deletedScope controls how FindOne/FindMany/Count/UpdateMany scope
+// soft-deleted documents into or out of their filter.
+type deletedScope int
+
+const (
+	// excludeDeleted excludes soft-deleted documents. It is the default.
+	excludeDeleted deletedScope = iota
+
+	// includeDeleted includes soft-deleted documents alongside the rest.
+	includeDeleted
+
+	// onlyDeleted includes only soft-deleted documents.
+	onlyDeleted
+)
+
+// WithDeleted includes soft-deleted documents alongside the rest.
+func WithDeleted() Option {
+	return func(o *option) {
+		o.deletedScope = includeDeleted
+	}
+}
+
+// OnlyDeleted restricts the result to only soft-deleted documents.
+func OnlyDeleted() Option {
+	return func(o *option) {
+		o.deletedScope = onlyDeleted
+	}
+}
+
+// scopeDeleted applies o.deletedScope to a copy of o.Filter, so it never
+// mutates the bson.M a caller passed to WithFilter as a side effect.
+func (o *option) scopeDeleted() {
+	filter := bson.M{}
+	for k, v := range o.Filter {
+		filter[k] = v
+	}
+
+	switch o.deletedScope {
+	case includeDeleted:
+	case onlyDeleted:
+		filter["deleted_at"] = bson.M{"$ne": nil}
+	default:
+		filter["deleted_at"] = nil
+	}
+
+	o.Filter = filter
+}
+
+// scopeDeletedFilter returns a copy of filter excluding soft-deleted
+// documents, for call sites that build a raw bson.M filter outside of
+// Option/bindOptions, e.g. BulkWrite's WriteOp constructors.
+func scopeDeletedFilter(filter bson.M) bson.M {
+	scoped := bson.M{}
+	for k, v := range filter {
+		scoped[k] = v
+	}
+
+	scoped["deleted_at"] = nil
+	return scoped
 }
 
 // Filter is a function to set filter.
@@ -138,6 +280,22 @@ func (o *option) setUpdate() {
 	}
 }
 
+// setUpsertInsert seeds created_at via $setOnInsert, so a document created
+// by Upsert gets the same timestamp bookkeeping InsertOne/InsertMany give
+// it, instead of decoding with a zero-value CreatedAt.
+func (o *option) setUpsertInsert() {
+	setOnInsert, ok := o.Update["$setOnInsert"].(bson.M)
+	if !ok {
+		setOnInsert = bson.M{}
+	}
+
+	if _, ok := setOnInsert["created_at"]; !ok {
+		setOnInsert["created_at"] = time.Now()
+	}
+
+	o.Update["$setOnInsert"] = setOnInsert
+}
+
 func (o *option) setSoftDelete() {
 	if o.Update == nil {
 		o.Update = bson.M{}
@@ -161,16 +319,36 @@ func bindOptions(options ...Option) *option {
 // Filter is a function to set filter.
 type collection[T entiter] struct {
 	*mongo.Collection
+
+	// sc is the session this collection is bound to, set via WithSession.
+	// When non-nil it is used instead of the ctx passed to each call so the
+	// call participates in sc's transaction.
+	sc mongo.SessionContext
 }
 
 // NewCollection is a function to create a new collection.
 func NewCollection[T entiter](c *mongo.Collection) Collecter[T] {
-	return &collection[T]{c}
+	return &collection[T]{Collection: c}
+}
+
+// WithSession returns a Collecter bound to sc, so that every call made
+// through it participates in sc's transaction.
+func (c *collection[T]) WithSession(sc mongo.SessionContext) Collecter[T] {
+	return &collection[T]{Collection: c.Collection, sc: sc}
+}
+
+// ctx returns sc if this collection is bound to a session, otherwise ctx.
+func (c *collection[T]) ctx(ctx context.Context) context.Context {
+	if c.sc != nil {
+		return c.sc
+	}
+
+	return ctx
 }
 
 // InsertOne insert one document.
 func (c *collection[T]) InsertOne(ctx context.Context, model T) error {
-	_, err := c.Collection.InsertOne(ctx, newRecord(model))
+	_, err := c.Collection.InsertOne(c.ctx(ctx), newRecord(model))
 	return err
 }
 
@@ -181,29 +359,31 @@ func (c *collection[T]) InsertMany(ctx context.Context, models []T) error {
 		docs[i] = newRecord(model)
 	}
 
-	_, err := c.Collection.InsertMany(ctx, docs)
+	_, err := c.Collection.InsertMany(c.ctx(ctx), docs)
 	return err
 }
 
 // FindOne find one document.
 func (c *collection[T]) FindOne(ctx context.Context, options ...Option) (*T, error) {
 	opt := bindOptions(options...)
+	opt.scopeDeleted()
 	var model T
-	err := c.Collection.FindOne(ctx, opt.Filter).Decode(&model)
+	err := c.Collection.FindOne(c.ctx(ctx), opt.Filter).Decode(&model)
 	return &model, err
 }
 
 // FindMany find many documents.
 func (c *collection[T]) FindMany(ctx context.Context, options ...Option) ([]T, error) {
 	opt := bindOptions(options...)
+	opt.scopeDeleted()
 
 	var models []T
-	cursor, err := c.Collection.Find(ctx, opt.Filter, opt.findOptions())
+	cursor, err := c.Collection.Find(c.ctx(ctx), opt.Filter, opt.findOptions())
 	if err != nil {
 		return nil, err
 	}
 
-	if err = cursor.All(ctx, &models); err != nil {
+	if err = cursor.All(c.ctx(ctx), &models); err != nil {
 		return nil, err
 	}
 
@@ -213,30 +393,32 @@ func (c *collection[T]) FindMany(ctx context.Context, options ...Option) ([]T, e
 // UpdateOne update one document.
 func (c *collection[T]) UpdateOne(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
+	opt.scopeDeleted()
 	opt.setUpdate()
-	_, err := c.Collection.UpdateOne(ctx, opt.Filter, opt.Update)
+	_, err := c.Collection.UpdateOne(c.ctx(ctx), opt.Filter, opt.Update)
 	return err
 }
 
 // UpdateMany update many documents.
 func (c *collection[T]) UpdateMany(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
+	opt.scopeDeleted()
 	opt.setUpdate()
-	_, err := c.Collection.UpdateMany(ctx, opt.Filter, opt.Update)
+	_, err := c.Collection.UpdateMany(c.ctx(ctx), opt.Filter, opt.Update)
 	return err
 }
 
 // DeleteOne delete one document.
 func (c *collection[T]) DeleteOne(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
-	_, err := c.Collection.DeleteOne(ctx, opt.Filter)
+	_, err := c.Collection.DeleteOne(c.ctx(ctx), opt.Filter)
 	return err
 }
 
 // DeleteMany delete many documents.
 func (c *collection[T]) DeleteMany(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
-	_, err := c.Collection.DeleteMany(ctx, opt.Filter)
+	_, err := c.Collection.DeleteMany(c.ctx(ctx), opt.Filter)
 	return err
 }
 
@@ -244,7 +426,7 @@ func (c *collection[T]) DeleteMany(ctx context.Context, options ...Option) error
 func (c *collection[T]) SoftDeleteOne(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
 	opt.setSoftDelete()
-	_, err := c.Collection.UpdateOne(ctx, opt.Filter, opt.Update)
+	_, err := c.Collection.UpdateOne(c.ctx(ctx), opt.Filter, opt.Update)
 	return err
 }
 
@@ -252,27 +434,43 @@ func (c *collection[T]) SoftDeleteOne(ctx context.Context, options ...Option) er
 func (c *collection[T]) SoftDeleteMany(ctx context.Context, options ...Option) error {
 	opt := bindOptions(options...)
 	opt.setSoftDelete()
-	_, err := c.Collection.UpdateMany(ctx, opt.Filter, opt.Update)
+	_, err := c.Collection.UpdateMany(c.ctx(ctx), opt.Filter, opt.Update)
+	return err
+}
+
+// Restore unsets deleted_at on documents matching the given filter, undoing
+// a prior SoftDeleteOne/SoftDeleteMany.
+func (c *collection[T]) Restore(ctx context.Context, options ...Option) error {
+	opt := bindOptions(options...)
+	opt.Update = bson.M{"$set": bson.M{"deleted_at": nil}}
+	_, err := c.Collection.UpdateMany(c.ctx(ctx), opt.Filter, opt.Update)
+	return err
+}
+
+// EnsureIndexes creates indexes, leaving any that already exist untouched.
+func (c *collection[T]) EnsureIndexes(ctx context.Context, indexes []mongo.IndexModel) error {
+	_, err := c.Collection.Indexes().CreateMany(c.ctx(ctx), indexes)
 	return err
 }
 
 // Count count documents.
 func (c *collection[T]) Count(ctx context.Context, options ...Option) (int64, error) {
 	opt := bindOptions(options...)
-	return c.Collection.CountDocuments(ctx, opt.Filter)
+	opt.scopeDeleted()
+	return c.Collection.CountDocuments(c.ctx(ctx), opt.Filter)
 }
 
 // Aggregate aggregate documents.
 func (c *collection[T]) Aggregate(ctx context.Context, options ...Option) ([]T, error) {
 	opt := bindOptions(options...)
 
-	cursor, err := c.Collection.Aggregate(ctx, opt.Pipeline)
+	cursor, err := c.Collection.Aggregate(c.ctx(ctx), opt.Pipeline)
 	if err != nil {
 		return nil, err
 	}
 
 	var models []T
-	if err = cursor.All(ctx, &models); err != nil {
+	if err = cursor.All(c.ctx(ctx), &models); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,58 @@
+package mgo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// fakeSessionContext satisfies mongo.SessionContext by embedding it as a
+// nil interface; it is only ever used as a non-nil marker value in tests,
+// never dereferenced.
+type fakeSessionContext struct {
+	mongo.SessionContext
+}
+
+func TestCollectionCtx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without a bound session, returns ctx unchanged", func(t *testing.T) {
+		c := &collection[testDoc]{}
+		if got := c.ctx(ctx); got != ctx {
+			t.Errorf("ctx() = %v, want the passed-in ctx", got)
+		}
+	})
+
+	t.Run("WithSession binds sc so calls participate in its transaction", func(t *testing.T) {
+		sc := fakeSessionContext{}
+		bound := (&collection[testDoc]{}).WithSession(sc).(*collection[testDoc])
+
+		if got := bound.ctx(ctx); got != sc {
+			t.Errorf("ctx() = %v, want the bound session %v", got, sc)
+		}
+	})
+}
+
+// testDoc is a minimal entiter for tests that need a concrete T.
+type testDoc struct {
+	Entity `bson:",inline"`
+}
+
+func TestScopeDeletedDoesNotMutateCallersFilter(t *testing.T) {
+	callerFilter := bson.M{"status": "active"}
+
+	opt := bindOptions(WithFilter(callerFilter))
+	opt.scopeDeleted()
+
+	if _, ok := callerFilter["deleted_at"]; ok {
+		t.Errorf("scopeDeleted() mutated the caller's filter: %#v", callerFilter)
+	}
+
+	want := bson.M{"status": "active", "deleted_at": nil}
+	if !reflect.DeepEqual(opt.Filter, want) {
+		t.Errorf("opt.Filter = %#v, want %#v", opt.Filter, want)
+	}
+}
@@ -0,0 +1,47 @@
+package mgo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSetUpsertInsertSeedsCreatedAtOnlyOnce(t *testing.T) {
+	opt := &option{Update: bson.M{}}
+	opt.setUpdate()
+	opt.setUpsertInsert()
+
+	setOnInsert, ok := opt.Update["$setOnInsert"].(bson.M)
+	if !ok {
+		t.Fatalf("Update[$setOnInsert] = %#v, want a bson.M", opt.Update["$setOnInsert"])
+	}
+
+	createdAt, ok := setOnInsert["created_at"]
+	if !ok {
+		t.Fatal("setUpsertInsert() did not seed created_at")
+	}
+
+	// A caller-supplied created_at must win over the auto-seeded one.
+	opt2 := &option{Update: bson.M{"$setOnInsert": bson.M{"created_at": createdAt}}}
+	opt2.setUpdate()
+	opt2.setUpsertInsert()
+
+	if got := opt2.Update["$setOnInsert"].(bson.M)["created_at"]; got != createdAt {
+		t.Errorf("setUpsertInsert() overwrote an existing created_at: got %v, want %v", got, createdAt)
+	}
+}
+
+func TestStripIDUsesUpdatedAtFieldName(t *testing.T) {
+	doc, err := stripID(testDoc{})
+	if err != nil {
+		t.Fatalf("stripID() error = %v", err)
+	}
+
+	if _, ok := doc["updated_at"]; !ok {
+		t.Errorf("stripID() doc = %#v, want an updated_at field matching setUpdate's", doc)
+	}
+
+	if _, ok := doc["_id"]; ok {
+		t.Errorf("stripID() doc = %#v, want _id stripped", doc)
+	}
+}
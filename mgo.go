@@ -36,25 +36,40 @@ func readConfig() *config {
 	return cfg
 }
 
-// client is a wrapper around the mongo.Client type.
-type client struct {
+// Client is a wrapper around the mongo.Client type. In addition to the
+// embedded driver methods, it exposes Watch for database-level change
+// streams and WithTransaction for multi-collection transactions.
+type Client struct {
 	*mongo.Client
 	dbName string
 }
 
 // Database returns a handle for a given database.
-func (c *client) Database() *mongo.Database {
+func (c *Client) Database() *mongo.Database {
 	return c.Client.Database(c.dbName)
 }
 
-// New creates a new MongoDB client and establishes a connection.
-func New() (db *mongo.Database, cleanup func(), err error) {
+// New creates a new MongoDB client and establishes a connection. The
+// returned Client is needed for Watch and WithTransaction; most callers can
+// otherwise ignore it and use db directly.
+func New() (db *mongo.Database, client *Client, cleanup func(), err error) {
 	c, err := connect()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return c.Database(), func() {
+	bootstrapCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := bootstrap(bootstrapCtx, c); err != nil {
+		disconnectCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		c.Disconnect(disconnectCtx)
+		return nil, nil, nil, err
+	}
+
+	return c.Database(), c, func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
@@ -63,7 +78,7 @@ func New() (db *mongo.Database, cleanup func(), err error) {
 }
 
 // connect creates a new MongoDB client and establishes a connection.
-func connect() (*client, error) {
+func connect() (*Client, error) {
 	cfg := readConfig()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -88,7 +103,7 @@ func connect() (*client, error) {
 		return nil, err
 	}
 
-	return &client{
+	return &Client{
 		Client: c,
 		dbName: cfg.Name,
 	}, nil
@@ -0,0 +1,169 @@
+package mgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// transactionsNotSupportedCode is the server error code mongod returns when
+// a transaction is started against a standalone deployment (no replica
+// set), e.g. the common local/dev/CI topology.
+const transactionsNotSupportedCode = 20
+
+// migrationsCollection stores which migrations have already been applied,
+// keyed by "<collection>/<migration name>", so registering indexes and
+// migrations on every startup stays idempotent.
+const migrationsCollection = "_mgo_migrations"
+
+// Migration is a single, named, idempotent schema change for a collection.
+// Down should undo Up and is only used by Rollback.
+type Migration struct {
+	// Name identifies the migration. It must be unique within the
+	// collection it is registered for.
+	Name string
+
+	// Up applies the migration.
+	Up func(ctx context.Context, db *mongo.Database) error
+
+	// Down undoes the migration, for Rollback.
+	Down func(ctx context.Context, db *mongo.Database) error
+}
+
+// registration is a collection's declared indexes and migrations, recorded
+// via Register and applied by New on startup.
+type registration struct {
+	name       string
+	indexes    []mongo.IndexModel
+	migrations []Migration
+}
+
+// registry holds every Register call made at init time.
+var registry []registration
+
+// Register declares the indexes and migrations for the collection called
+// name. New applies them, in order, after it connects: indexes via
+// Collection.Indexes().CreateMany, and any migration not yet recorded in
+// the _mgo_migrations collection via its Up func, inside a transaction
+// where the deployment topology supports one.
+func Register(name string, indexes []mongo.IndexModel, migrations []Migration) {
+	registry = append(registry, registration{
+		name:       name,
+		indexes:    indexes,
+		migrations: migrations,
+	})
+}
+
+// bootstrap applies every registered collection's indexes and pending
+// migrations.
+func bootstrap(ctx context.Context, c *Client) error {
+	db := c.Database()
+	migrations := db.Collection(migrationsCollection)
+
+	for _, reg := range registry {
+		if len(reg.indexes) > 0 {
+			if _, err := db.Collection(reg.name).Indexes().CreateMany(ctx, reg.indexes); err != nil {
+				return fmt.Errorf("mgo: ensure indexes for %q: %w", reg.name, err)
+			}
+		}
+
+		for _, m := range reg.migrations {
+			if err := applyMigration(ctx, c, migrations, db, reg.name, m); err != nil {
+				return fmt.Errorf("mgo: migrate %q/%q: %w", reg.name, m.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func migrationID(collectionName, migrationName string) string {
+	return collectionName + "/" + migrationName
+}
+
+func applyMigration(ctx context.Context, c *Client, migrations *mongo.Collection, db *mongo.Database, collectionName string, m Migration) error {
+	id := migrationID(collectionName, m.Name)
+
+	count, err := migrations.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	err = c.WithTransaction(ctx, func(sc mongo.SessionContext) error {
+		if err := m.Up(sc, db); err != nil {
+			return err
+		}
+
+		_, err := migrations.InsertOne(sc, bson.M{"_id": id, "applied_at": time.Now()})
+		return err
+	})
+
+	if !transactionsNotSupported(err) {
+		return err
+	}
+
+	// The deployment has no replica set (e.g. a standalone mongod), so
+	// transactions aren't available. Nothing committed from the attempt
+	// above, since the very first operation inside it is rejected before
+	// it runs. Fall back to applying the migration non-transactionally.
+	if err := m.Up(ctx, db); err != nil {
+		return err
+	}
+
+	_, err = migrations.InsertOne(ctx, bson.M{"_id": id, "applied_at": time.Now()})
+	return err
+}
+
+// transactionsNotSupported reports whether err is the server rejecting a
+// transaction because the deployment doesn't support one.
+func transactionsNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == transactionsNotSupportedCode {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "Transaction numbers are only allowed on a replica set member or mongos")
+}
+
+// Rollback runs the Down func of the named migration registered for
+// collectionName and removes its record from _mgo_migrations, so it will
+// be re-applied on the next startup.
+func Rollback(ctx context.Context, db *mongo.Database, collectionName, migrationName string) error {
+	for _, reg := range registry {
+		if reg.name != collectionName {
+			continue
+		}
+
+		for _, m := range reg.migrations {
+			if m.Name != migrationName {
+				continue
+			}
+
+			if m.Down == nil {
+				return fmt.Errorf("mgo: migration %q/%q has no Down", collectionName, migrationName)
+			}
+
+			if err := m.Down(ctx, db); err != nil {
+				return err
+			}
+
+			_, err := db.Collection(migrationsCollection).DeleteOne(ctx, bson.M{"_id": migrationID(collectionName, migrationName)})
+			return err
+		}
+	}
+
+	return errors.New("mgo: migration " + migrationID(collectionName, migrationName) + " is not registered")
+}
@@ -0,0 +1,57 @@
+package mgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestTransactionsNotSupported(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "command error with the standalone-topology code",
+			err:  mongo.CommandError{Code: transactionsNotSupportedCode, Message: "Transaction numbers are only allowed on a replica set member or mongos"},
+			want: true,
+		},
+		{
+			name: "command error wrapped by a caller",
+			err:  fmt.Errorf("mgo: migrate %q: %w", "users/add-index", mongo.CommandError{Code: transactionsNotSupportedCode}),
+			want: true,
+		},
+		{
+			name: "unrelated command error",
+			err:  mongo.CommandError{Code: 11000, Message: "duplicate key"},
+			want: false,
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transactionsNotSupported(tt.err); got != tt.want {
+				t.Errorf("transactionsNotSupported(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrationID(t *testing.T) {
+	if got, want := migrationID("users", "add-email-index"), "users/add-email-index"; got != want {
+		t.Errorf("migrationID() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+package mgo
+
+import "go.mongodb.org/mongo-driver/bson"
+
+// WithFilter sets the filter to find, update or delete by.
+func WithFilter(filter bson.M) Option {
+	return func(o *option) {
+		o.Filter = filter
+	}
+}
+
+// WithUpdate sets the update document for UpdateOne/UpdateMany.
+func WithUpdate(update bson.M) Option {
+	return func(o *option) {
+		o.Update = update
+	}
+}
+
+// WithSort sets the sort order for FindMany/FindPage; FindOne does not apply
+// it. Key order matters: it determines both the order mongo sorts by and
+// which key FindPage anchors its keyset pagination on.
+func WithSort(sort bson.D) Option {
+	return func(o *option) {
+		o.Sort = sort
+	}
+}
+
+// WithProjection sets the fields to include or exclude for FindMany/FindPage;
+// FindOne does not apply it.
+func WithProjection(projection bson.M) Option {
+	return func(o *option) {
+		o.Projection = projection
+	}
+}
+
+// WithPipeline sets the aggregation pipeline for Aggregate.
+func WithPipeline(pipeline []bson.M) Option {
+	return func(o *option) {
+		o.Pipeline = pipeline
+	}
+}
+
+// WithSkip sets the number of documents to skip for FindMany.
+func WithSkip(skip int64) Option {
+	return func(o *option) {
+		o.Skip = &skip
+	}
+}
+
+// WithLimit sets the maximum number of documents to return for FindMany.
+func WithLimit(limit int64) Option {
+	return func(o *option) {
+		o.Limit = &limit
+	}
+}
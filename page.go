@@ -0,0 +1,210 @@
+package mgo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pageCursor is the decoded form of a FindPage token: the sort field and
+// value of the last document on the previous page, plus its _id as a
+// tie-breaker for documents that share the same sort value. Type records
+// how Value was encoded, for sort fields (e.g. ObjectID, time.Time) whose
+// JSON representation needs converting back on decode.
+type pageCursor struct {
+	Field string `json:"field"`
+	Value any    `json:"value"`
+	Type  string `json:"type,omitempty"`
+	ID    string `json:"id"`
+}
+
+const (
+	valueTypeTime     = "time"
+	valueTypeObjectID = "objectid"
+)
+
+func encodePageToken(field string, value any, id primitive.ObjectID) (string, error) {
+	var valueType string
+
+	switch v := value.(type) {
+	case time.Time:
+		value = v.Format(time.RFC3339Nano)
+		valueType = valueTypeTime
+	case primitive.DateTime:
+		value = v.Time().Format(time.RFC3339Nano)
+		valueType = valueTypeTime
+	case primitive.ObjectID:
+		value = v.Hex()
+		valueType = valueTypeObjectID
+	}
+
+	data, err := json.Marshal(pageCursor{Field: field, Value: value, Type: valueType, ID: id.Hex()})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(token string) (pageCursor, error) {
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, err
+	}
+
+	var cur pageCursor
+	if err := json.Unmarshal(data, &cur); err != nil {
+		return pageCursor{}, err
+	}
+
+	s, ok := cur.Value.(string)
+	if !ok {
+		return cur, nil
+	}
+
+	switch cur.Type {
+	case valueTypeTime:
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			cur.Value = t
+		}
+	case valueTypeObjectID:
+		if oid, err := primitive.ObjectIDFromHex(s); err == nil {
+			cur.Value = oid
+		}
+	}
+
+	return cur, nil
+}
+
+// primarySort returns the first field of sort and whether it is descending.
+// FindPage only resumes on a single sort key: the first one, deterministically,
+// since sort is ordered (bson.D) rather than a map.
+func primarySort(sort bson.D) (field string, desc bool) {
+	if len(sort) == 0 {
+		return "", false
+	}
+
+	e := sort[0]
+	field = e.Key
+
+	switch n := e.Value.(type) {
+	case int:
+		desc = n < 0
+	case int32:
+		desc = n < 0
+	case int64:
+		desc = n < 0
+	}
+
+	return field, desc
+}
+
+// pageTiebreak builds the second $or clause of FindPage's keyset filter:
+// "same sort value as the last page, but a later _id". It's built up
+// rather than as a single map literal with both field and "_id" as keys,
+// since when field is "_id" (the default sort) a literal's duplicate key
+// would silently drop one of the two.
+func pageTiebreak(field, cmp string, value any, id primitive.ObjectID) bson.M {
+	tiebreak := bson.M{"_id": bson.M{cmp: id}}
+	if field != "_id" {
+		tiebreak[field] = value
+	}
+
+	return tiebreak
+}
+
+// FindPage finds up to limit documents after the document identified by
+// token, ordered by options' Sort (defaulting to _id ascending). It
+// returns the next page's token, or an empty string once there are no more
+// documents. Unlike FindMany's Skip/Limit, resuming from token compiles to
+// a $gt/$lt filter on the sort key, so paging stays index-friendly however
+// deep the caller goes.
+func (c *collection[T]) FindPage(ctx context.Context, token string, limit int64, options ...Option) ([]T, string, error) {
+	opt := bindOptions(options...)
+	opt.scopeDeleted()
+
+	if opt.Sort == nil {
+		opt.Sort = bson.D{{Key: "_id", Value: 1}}
+	}
+
+	field, desc := primarySort(opt.Sort)
+
+	// Order ties on the sort field by _id in the same direction, so the _id
+	// tie-breaker in the resume filter below matches the page order.
+	hasID := false
+	for _, e := range opt.Sort {
+		hasID = hasID || e.Key == "_id"
+	}
+	if !hasID {
+		idOrder := int32(1)
+		if desc {
+			idOrder = -1
+		}
+		opt.Sort = append(append(bson.D{}, opt.Sort...), bson.E{Key: "_id", Value: idOrder})
+	}
+
+	if token != "" {
+		cur, err := decodePageToken(token)
+		if err != nil {
+			return nil, "", err
+		}
+
+		id, err := primitive.ObjectIDFromHex(cur.ID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		cmp := "$gt"
+		if desc {
+			cmp = "$lt"
+		}
+
+		if opt.Filter == nil {
+			opt.Filter = bson.M{}
+		}
+
+		opt.Filter["$or"] = []bson.M{
+			{field: bson.M{cmp: cur.Value}},
+			pageTiebreak(field, cmp, cur.Value, id),
+		}
+	}
+
+	opt.Limit = &limit
+
+	var models []T
+	cursor, err := c.Collection.Find(c.ctx(ctx), opt.Filter, opt.findOptions())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := cursor.All(c.ctx(ctx), &models); err != nil {
+		return nil, "", err
+	}
+
+	if len(models) < int(limit) {
+		return models, "", nil
+	}
+
+	last := any(models[len(models)-1]).(entiter).entity()
+
+	data, err := bson.Marshal(models[len(models)-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return nil, "", err
+	}
+
+	nextToken, err := encodePageToken(field, doc[field], last.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return models, nextToken, nil
+}
@@ -0,0 +1,119 @@
+package mgo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPrimarySortIsDeterministicForMultiKeySort(t *testing.T) {
+	sort := bson.D{
+		{Key: "created_at", Value: int32(-1)},
+		{Key: "name", Value: int32(1)},
+	}
+
+	// Run several times: with a bson.M this could flip between runs since
+	// map iteration order is unspecified; bson.D must stay stable.
+	for i := 0; i < 5; i++ {
+		field, desc := primarySort(sort)
+		if field != "created_at" || !desc {
+			t.Fatalf("primarySort(%v) = (%q, %v), want (\"created_at\", true)", sort, field, desc)
+		}
+	}
+}
+
+func TestPrimarySortEmpty(t *testing.T) {
+	if field, desc := primarySort(nil); field != "" || desc {
+		t.Errorf("primarySort(nil) = (%q, %v), want (\"\", false)", field, desc)
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("string value", func(t *testing.T) {
+		token, err := encodePageToken("name", "alice", id)
+		if err != nil {
+			t.Fatalf("encodePageToken() error = %v", err)
+		}
+
+		cur, err := decodePageToken(token)
+		if err != nil {
+			t.Fatalf("decodePageToken() error = %v", err)
+		}
+
+		if cur.Field != "name" || cur.Value != "alice" || cur.ID != id.Hex() {
+			t.Errorf("decodePageToken() = %#v, want field=name value=alice id=%s", cur, id.Hex())
+		}
+	})
+
+	t.Run("time.Time value survives the round trip", func(t *testing.T) {
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		token, err := encodePageToken("created_at", now, id)
+		if err != nil {
+			t.Fatalf("encodePageToken() error = %v", err)
+		}
+
+		cur, err := decodePageToken(token)
+		if err != nil {
+			t.Fatalf("decodePageToken() error = %v", err)
+		}
+
+		got, ok := cur.Value.(time.Time)
+		if !ok {
+			t.Fatalf("decodePageToken() Value = %#v (%T), want a time.Time", cur.Value, cur.Value)
+		}
+
+		if !got.Equal(now) {
+			t.Errorf("decodePageToken() Value = %v, want %v", got, now)
+		}
+	})
+
+	t.Run("ObjectID value survives the round trip", func(t *testing.T) {
+		sortValue := primitive.NewObjectID()
+
+		token, err := encodePageToken("_id", sortValue, id)
+		if err != nil {
+			t.Fatalf("encodePageToken() error = %v", err)
+		}
+
+		cur, err := decodePageToken(token)
+		if err != nil {
+			t.Fatalf("decodePageToken() error = %v", err)
+		}
+
+		got, ok := cur.Value.(primitive.ObjectID)
+		if !ok {
+			t.Fatalf("decodePageToken() Value = %#v (%T), want a primitive.ObjectID", cur.Value, cur.Value)
+		}
+
+		if got != sortValue {
+			t.Errorf("decodePageToken() Value = %v, want %v", got, sortValue)
+		}
+	})
+}
+
+func TestPageTiebreakSurvivesDefaultIDSort(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	got := pageTiebreak("_id", "$gt", primitive.NewObjectID(), id)
+
+	want := bson.M{"_id": bson.M{"$gt": id}}
+	if len(got) != 1 || got["_id"].(bson.M)["$gt"] != id {
+		t.Errorf("pageTiebreak(\"_id\", ...) = %#v, want %#v", got, want)
+	}
+}
+
+func TestPageTiebreakKeepsBothClausesForNonIDSort(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	got := pageTiebreak("created_at", "$lt", "2026-01-01", id)
+
+	want := bson.M{"_id": bson.M{"$lt": id}, "created_at": "2026-01-01"}
+	if len(got) != 2 || got["_id"].(bson.M)["$lt"] != id || got["created_at"] != "2026-01-01" {
+		t.Errorf("pageTiebreak(\"created_at\", ...) = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,312 @@
+package mgo
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Query is a chainable query builder that compiles down to the Option
+// functions already accepted by Collecter. It is an alternative to passing
+// Option values directly, for callers who prefer to build up a filter
+// incrementally.
+type Query[T any] struct {
+	c            Collecter[T]
+	filter       bson.M
+	sort         bson.D
+	proj         bson.M
+	skip         *int64
+	limit        *int64
+	lookups      []bson.M
+	deletedScope deletedScope
+}
+
+// Query starts a chainable query against c.
+func (c *collection[T]) Query() *Query[T] {
+	return &Query[T]{c: c, filter: bson.M{}}
+}
+
+// Filter sets filter[field] = value.
+func (q *Query[T]) Filter(field string, value any) *Query[T] {
+	q.filter[field] = value
+	return q
+}
+
+// Gte adds a {field: {$gte: value}} condition.
+func (q *Query[T]) Gte(field string, value any) *Query[T] {
+	return q.op(field, "$gte", value)
+}
+
+// Gt adds a {field: {$gt: value}} condition.
+func (q *Query[T]) Gt(field string, value any) *Query[T] {
+	return q.op(field, "$gt", value)
+}
+
+// Lte adds a {field: {$lte: value}} condition.
+func (q *Query[T]) Lte(field string, value any) *Query[T] {
+	return q.op(field, "$lte", value)
+}
+
+// Lt adds a {field: {$lt: value}} condition.
+func (q *Query[T]) Lt(field string, value any) *Query[T] {
+	return q.op(field, "$lt", value)
+}
+
+// In adds a {field: {$in: values}} condition.
+func (q *Query[T]) In(field string, values ...any) *Query[T] {
+	return q.op(field, "$in", values)
+}
+
+// Nin adds a {field: {$nin: values}} condition.
+func (q *Query[T]) Nin(field string, values ...any) *Query[T] {
+	return q.op(field, "$nin", values)
+}
+
+// Regex adds a {field: {$regex: pattern}} condition.
+func (q *Query[T]) Regex(field, pattern string) *Query[T] {
+	return q.op(field, "$regex", pattern)
+}
+
+// Exists adds a {field: {$exists: exists}} condition.
+func (q *Query[T]) Exists(field string, exists bool) *Query[T] {
+	return q.op(field, "$exists", exists)
+}
+
+// Or adds an $or condition made up of the given queries' filters.
+func (q *Query[T]) Or(queries ...*Query[T]) *Query[T] {
+	return q.combine("$or", queries)
+}
+
+// And adds an $and condition made up of the given queries' filters.
+func (q *Query[T]) And(queries ...*Query[T]) *Query[T] {
+	return q.combine("$and", queries)
+}
+
+func (q *Query[T]) combine(operator string, queries []*Query[T]) *Query[T] {
+	filters := make([]bson.M, len(queries))
+	for i, query := range queries {
+		filters[i] = query.filter
+	}
+
+	q.filter[operator] = filters
+	return q
+}
+
+func (q *Query[T]) op(field, operator string, value any) *Query[T] {
+	cond, ok := q.filter[field].(bson.M)
+	if !ok {
+		cond = bson.M{}
+	}
+
+	cond[operator] = value
+	q.filter[field] = cond
+	return q
+}
+
+// Sort orders by field ascending, or descending when field is prefixed
+// with "-", e.g. Sort("-created_at").
+func (q *Query[T]) Sort(field string) *Query[T] {
+	order := int32(1)
+	if strings.HasPrefix(field, "-") {
+		field = strings.TrimPrefix(field, "-")
+		order = -1
+	}
+
+	for i, e := range q.sort {
+		if e.Key == field {
+			q.sort[i].Value = order
+			return q
+		}
+	}
+
+	q.sort = append(q.sort, bson.E{Key: field, Value: order})
+	return q
+}
+
+// Skip skips the first n documents.
+func (q *Query[T]) Skip(n int64) *Query[T] {
+	q.skip = &n
+	return q
+}
+
+// Limit caps the result at n documents.
+func (q *Query[T]) Limit(n int64) *Query[T] {
+	q.limit = &n
+	return q
+}
+
+// Project selects which fields to include in the result.
+func (q *Query[T]) Project(fields ...string) *Query[T] {
+	if q.proj == nil {
+		q.proj = bson.M{}
+	}
+
+	for _, field := range fields {
+		q.proj[field] = 1
+	}
+
+	return q
+}
+
+// WithDeleted includes soft-deleted documents alongside the rest.
+func (q *Query[T]) WithDeleted() *Query[T] {
+	q.deletedScope = includeDeleted
+	return q
+}
+
+// OnlyDeleted restricts the query to only soft-deleted documents.
+func (q *Query[T]) OnlyDeleted() *Query[T] {
+	q.deletedScope = onlyDeleted
+	return q
+}
+
+// Populate joins the referenced collection "from" via a $lookup stage,
+// matching localField against foreignField and storing the result in as.
+// Using Populate switches the query to run as an aggregation pipeline.
+func (q *Query[T]) Populate(from, localField, foreignField, as string) *Query[T] {
+	q.lookups = append(q.lookups, bson.M{
+		"$lookup": bson.M{
+			"from":         from,
+			"localField":   localField,
+			"foreignField": foreignField,
+			"as":           as,
+		},
+	})
+
+	return q
+}
+
+// options compiles the builder state into the Option functions accepted by
+// Collecter's FindOne/FindMany/Count/UpdateMany.
+func (q *Query[T]) options() []Option {
+	filter := bson.M{}
+	for k, v := range q.filter {
+		filter[k] = v
+	}
+
+	opts := []Option{WithFilter(filter)}
+
+	switch q.deletedScope {
+	case includeDeleted:
+		opts = append(opts, WithDeleted())
+	case onlyDeleted:
+		opts = append(opts, OnlyDeleted())
+	}
+
+	if q.sort != nil {
+		opts = append(opts, WithSort(q.sort))
+	}
+
+	if q.proj != nil {
+		opts = append(opts, WithProjection(q.proj))
+	}
+
+	if q.skip != nil {
+		opts = append(opts, WithSkip(*q.skip))
+	}
+
+	if q.limit != nil {
+		opts = append(opts, WithLimit(*q.limit))
+	}
+
+	return opts
+}
+
+// matchFilter copies q.filter and applies q.deletedScope to it, so Populate
+// queries get the same soft-delete scoping as FindOne/FindMany instead of
+// silently returning deleted documents.
+func (q *Query[T]) matchFilter() bson.M {
+	filter := bson.M{}
+	for k, v := range q.filter {
+		filter[k] = v
+	}
+
+	switch q.deletedScope {
+	case includeDeleted:
+	case onlyDeleted:
+		filter["deleted_at"] = bson.M{"$ne": nil}
+	default:
+		filter["deleted_at"] = nil
+	}
+
+	return filter
+}
+
+// pipeline compiles the builder state into an aggregation pipeline, used
+// once Populate has been called.
+func (q *Query[T]) pipeline() []bson.M {
+	stages := append([]bson.M{{"$match": q.matchFilter()}}, q.lookups...)
+
+	if q.sort != nil {
+		stages = append(stages, bson.M{"$sort": q.sort})
+	}
+
+	if q.skip != nil {
+		stages = append(stages, bson.M{"$skip": *q.skip})
+	}
+
+	if q.limit != nil {
+		stages = append(stages, bson.M{"$limit": *q.limit})
+	}
+
+	if q.proj != nil {
+		stages = append(stages, bson.M{"$project": q.proj})
+	}
+
+	return stages
+}
+
+// One runs the built query and returns the first result. Without Populate it
+// runs FindOne, which applies only the filter and deleted-document scope:
+// Sort, Skip and Project are not applied there.
+func (q *Query[T]) One(ctx context.Context) (*T, error) {
+	if len(q.lookups) == 0 {
+		return q.c.FindOne(ctx, q.options()...)
+	}
+
+	models, err := q.c.Aggregate(ctx, WithPipeline(q.pipeline()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(models) == 0 {
+		var zero T
+		return &zero, mongo.ErrNoDocuments
+	}
+
+	return &models[0], nil
+}
+
+// All runs the built query and decodes the result into out.
+func (q *Query[T]) All(ctx context.Context, out *[]T) error {
+	var (
+		models []T
+		err    error
+	)
+
+	if len(q.lookups) == 0 {
+		models, err = q.c.FindMany(ctx, q.options()...)
+	} else {
+		models, err = q.c.Aggregate(ctx, WithPipeline(q.pipeline()))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*out = models
+	return nil
+}
+
+// Count runs Count with the built query's filter and deleted-document scope.
+func (q *Query[T]) Count(ctx context.Context) (int64, error) {
+	return q.c.Count(ctx, q.options()...)
+}
+
+// Update runs UpdateMany with the built query's filter and deleted-document
+// scope.
+func (q *Query[T]) Update(ctx context.Context, update bson.M) error {
+	return q.c.UpdateMany(ctx, append(q.options(), WithUpdate(update))...)
+}
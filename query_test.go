@@ -0,0 +1,67 @@
+package mgo
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestQueryFilterBuilding(t *testing.T) {
+	q := (&Query[testDoc]{filter: bson.M{}}).
+		Filter("status", "active").
+		Gte("age", 18).
+		Lt("age", 65)
+
+	want := bson.M{
+		"status": "active",
+		"age":    bson.M{"$gte": 18, "$lt": 65},
+	}
+
+	if !reflect.DeepEqual(q.filter, want) {
+		t.Errorf("filter = %#v, want %#v", q.filter, want)
+	}
+}
+
+func TestQuerySortIsOrderedAndDeduped(t *testing.T) {
+	q := (&Query[testDoc]{filter: bson.M{}}).Sort("name").Sort("-created_at").Sort("name")
+
+	want := bson.D{
+		{Key: "name", Value: int32(1)},
+		{Key: "created_at", Value: int32(-1)},
+	}
+
+	if !reflect.DeepEqual(q.sort, want) {
+		t.Errorf("sort = %#v, want %#v", q.sort, want)
+	}
+}
+
+func TestQueryOptionsDoesNotMutateFilter(t *testing.T) {
+	q := (&Query[testDoc]{filter: bson.M{"status": "active"}}).OnlyDeleted()
+
+	_ = q.options()
+
+	want := bson.M{"status": "active"}
+	if !reflect.DeepEqual(q.filter, want) {
+		t.Errorf("q.filter was mutated by options(): got %#v, want %#v", q.filter, want)
+	}
+}
+
+func TestQueryMatchFilterScopesDeleted(t *testing.T) {
+	q := &Query[testDoc]{filter: bson.M{"status": "active"}}
+
+	if got := q.matchFilter(); got["deleted_at"] != nil {
+		t.Errorf("default matchFilter() deleted_at = %v, want nil", got["deleted_at"])
+	}
+
+	q.OnlyDeleted()
+	got := q.matchFilter()
+	if _, ok := got["deleted_at"].(bson.M)["$ne"]; !ok {
+		t.Errorf("OnlyDeleted matchFilter() = %#v, want a deleted_at $ne filter", got)
+	}
+
+	// matchFilter must not leak into q.filter itself.
+	if _, ok := q.filter["deleted_at"]; ok {
+		t.Errorf("matchFilter() mutated q.filter: %#v", q.filter)
+	}
+}
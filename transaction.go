@@ -0,0 +1,28 @@
+package mgo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WithTransaction runs fn inside a MongoDB session and transaction,
+// committing if fn returns nil and aborting otherwise. Pass sc into
+// Collecter.WithSession for every Collecter fn operates on so their
+// InsertOne/UpdateMany/SoftDelete* calls participate in the transaction,
+// e.g. to atomically debit one collection and credit another. c is the
+// *Client returned alongside *mongo.Database by New.
+func (c *Client) WithTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error, opts ...*options.TransactionOptions) error {
+	sess, err := c.Client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+
+	_, err = sess.WithTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		return nil, fn(sc)
+	}, opts...)
+
+	return err
+}
@@ -0,0 +1,204 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UpdateDescription describes the fields changed by an update operationType
+// within a ChangeEvent.
+type UpdateDescription struct {
+	// UpdatedFields is a document of updated fields and their new values.
+	UpdatedFields bson.M `bson:"updatedFields"`
+
+	// RemovedFields is a list of fields removed by the update.
+	RemovedFields []string `bson:"removedFields"`
+}
+
+// ChangeEvent is a decoded MongoDB change stream event.
+type ChangeEvent[T any] struct {
+	// OperationType is the type of operation, e.g. "insert", "update", "delete".
+	OperationType string
+
+	// DocumentKey is the _id (and shard key, if any) of the changed document.
+	DocumentKey bson.Raw
+
+	// FullDocument is the document as it looked after the change, decoded
+	// into T. It is nil when the driver did not return one, e.g. on delete
+	// events or when FullDocument=updateLookup was not requested.
+	FullDocument *T
+
+	// UpdateDescription describes the changed fields for update events.
+	UpdateDescription *UpdateDescription
+
+	// ResumeToken can be stored and passed to WithResumeAfter to resume the
+	// stream from this event.
+	ResumeToken bson.Raw
+}
+
+type rawChangeEvent struct {
+	OperationType     string             `bson:"operationType"`
+	DocumentKey       bson.Raw           `bson:"documentKey"`
+	FullDocument      bson.Raw           `bson:"fullDocument"`
+	UpdateDescription *UpdateDescription `bson:"updateDescription"`
+}
+
+// WithMaxAwaitTime sets the maximum amount of time the server waits for new
+// data changes before returning an empty batch for a Watch call.
+func WithMaxAwaitTime(d time.Duration) Option {
+	return func(o *option) {
+		o.MaxAwaitTime = &d
+	}
+}
+
+// WithResumeAfter resumes a Watch stream after the event identified by token.
+func WithResumeAfter(token bson.Raw) Option {
+	return func(o *option) {
+		o.ResumeAfter = token
+	}
+}
+
+// WithStartAfter starts a Watch stream after the event identified by token,
+// even if that event is an invalidate event.
+func WithStartAfter(token bson.Raw) Option {
+	return func(o *option) {
+		o.StartAfter = token
+	}
+}
+
+// WithFullDocument sets the FullDocument option of a Watch call, e.g.
+// options.UpdateLookup to have update events include the full document.
+func WithFullDocument(fd options.FullDocument) Option {
+	return func(o *option) {
+		o.FullDocument = fd
+	}
+}
+
+func (o option) changeStreamOptions() *options.ChangeStreamOptions {
+	csOpts := options.ChangeStream()
+
+	if o.MaxAwaitTime != nil {
+		csOpts.SetMaxAwaitTime(*o.MaxAwaitTime)
+	}
+
+	if o.ResumeAfter != nil {
+		csOpts.SetResumeAfter(o.ResumeAfter)
+	}
+
+	if o.StartAfter != nil {
+		csOpts.SetStartAfter(o.StartAfter)
+	}
+
+	if o.FullDocument != "" {
+		csOpts.SetFullDocument(o.FullDocument)
+	}
+
+	return csOpts
+}
+
+// resumableWatcher is implemented by both *mongo.Collection and
+// *mongo.Database, letting watch be shared between collection- and
+// database-scoped streams.
+type resumableWatcher interface {
+	Watch(context.Context, interface{}, ...*options.ChangeStreamOptions) (*mongo.ChangeStream, error)
+}
+
+// watch runs a change stream against src using pipeline and opt, decoding
+// each event's fullDocument into T and restarting the stream from the last
+// seen resume token whenever it is interrupted by a resumable error. The
+// returned channel is closed once ctx is done or a non-resumable error
+// occurs.
+func watch[T any](ctx context.Context, src resumableWatcher, pipeline []bson.M, opt *option) (<-chan ChangeEvent[T], error) {
+	csOpts := opt.changeStreamOptions()
+
+	stream, err := src.Watch(ctx, pipeline, csOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan ChangeEvent[T])
+
+	go func() {
+		defer close(events)
+
+		for {
+			for stream.Next(ctx) {
+				var raw rawChangeEvent
+				if err := stream.Decode(&raw); err != nil {
+					continue
+				}
+
+				event := ChangeEvent[T]{
+					OperationType:     raw.OperationType,
+					DocumentKey:       raw.DocumentKey,
+					UpdateDescription: raw.UpdateDescription,
+					ResumeToken:       stream.ResumeToken(),
+				}
+
+				if len(raw.FullDocument) > 0 {
+					var doc T
+					if err := bson.Unmarshal(raw.FullDocument, &doc); err == nil {
+						event.FullDocument = &doc
+					}
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					stream.Close(ctx)
+					return
+				}
+			}
+
+			err := stream.Err()
+			resumeToken := stream.ResumeToken()
+			stream.Close(ctx)
+
+			if ctx.Err() != nil || err == nil || !isResumableChangeStreamError(err) {
+				return
+			}
+
+			// The server rejects StartAfter and ResumeAfter together.
+			csOpts.StartAfter = nil
+			csOpts.SetResumeAfter(resumeToken)
+			stream, err = src.Watch(ctx, pipeline, csOpts)
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func isResumableChangeStreamError(err error) bool {
+	if mongo.IsNetworkError(err) {
+		return true
+	}
+
+	if cmdErr, ok := err.(mongo.CommandError); ok {
+		return cmdErr.HasErrorLabel("ResumableChangeStreamError")
+	}
+
+	return false
+}
+
+// Watch subscribes to a change stream scoped to this collection, decoding
+// each event's fullDocument into T and automatically resuming the stream
+// after transient errors using the last seen resume token.
+func (c *collection[T]) Watch(ctx context.Context, pipeline []bson.M, options ...Option) (<-chan ChangeEvent[T], error) {
+	opt := bindOptions(options...)
+	return watch[T](ctx, c.Collection, pipeline, opt)
+}
+
+// Watch subscribes to a database-level change stream, decoding each event's
+// fullDocument into a bson.M and automatically resuming the stream after
+// transient errors using the last seen resume token.
+func (c *Client) Watch(ctx context.Context, pipeline []bson.M, options ...Option) (<-chan ChangeEvent[bson.M], error) {
+	opt := bindOptions(options...)
+	return watch[bson.M](ctx, c.Database(), pipeline, opt)
+}
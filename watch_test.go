@@ -0,0 +1,40 @@
+package mgo
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestIsResumableChangeStreamError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "labeled resumable command error",
+			err:  mongo.CommandError{Labels: []string{"ResumableChangeStreamError"}},
+			want: true,
+		},
+		{
+			name: "command error without the label",
+			err:  mongo.CommandError{Code: 11600, Message: "interrupted"},
+			want: false,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isResumableChangeStreamError(tt.err); got != tt.want {
+				t.Errorf("isResumableChangeStreamError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}